@@ -0,0 +1,53 @@
+package qbridge
+
+import (
+	"errors"
+	"fmt"
+)
+
+// APIError is returned for any non-2xx response from the gateway. Code
+// mirrors the gateway's stable `code` field (see BridgeError::code in the
+// Rust source) and should be matched on in preference to Message, which is
+// free-form and may change wording between releases.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("qbridge: %s (%s)", e.Message, e.Code)
+	}
+	return fmt.Sprintf("qbridge: http %d: %s", e.StatusCode, e.Message)
+}
+
+// Known values of APIError.Code, matching BridgeError::code in the gateway.
+const (
+	CodeValidation         = "validation"
+	CodeBackendUnavailable = "backend_unavailable"
+	CodeInternal           = "internal"
+	CodeForbidden          = "forbidden"
+	CodeQueueFull          = "queue_full"
+	CodeRateLimited        = "rate_limited"
+	CodePayloadTooLarge    = "payload_too_large"
+)
+
+// ErrResultPending is returned by GetResult when the gateway has no result
+// yet for a request ID (still queued, still running, or the ID never
+// existed — a bare GET can't tell those apart).
+var ErrResultPending = errors.New("qbridge: result not ready yet")
+
+// IsErrResultPending reports whether err is (or wraps) ErrResultPending.
+func IsErrResultPending(err error) bool {
+	return errors.Is(err, ErrResultPending)
+}
+
+// IsCode reports whether err is an *APIError carrying the given code.
+func IsCode(err error, code string) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == code
+	}
+	return false
+}
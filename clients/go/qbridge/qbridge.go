@@ -0,0 +1,256 @@
+// Package qbridge is the official Go client for the Q-Bridge API gateway.
+//
+// It covers the same request lifecycle the HTTP API exposes: submit a
+// request, then either poll for its result or wait for it with a timeout.
+package qbridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Client talks to a single Q-Bridge gateway instance.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	authToken  string
+
+	// MaxRetries is how many times a request is retried after a transient
+	// failure (network error, or a 5xx/429 response) before giving up.
+	// Retries use a fixed 200ms backoff, matching the gateway's own
+	// Retry-After hints closely enough not to make backpressure worse.
+	MaxRetries int
+}
+
+// Option configures a Client constructed by New.
+type Option func(*Client)
+
+// WithHTTPClient overrides the default http.Client, e.g. to set a transport
+// with custom TLS settings.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithAuthToken sets the bearer token sent with every request, for gateways
+// configured with jwt_validator or an admin token requirement.
+func WithAuthToken(token string) Option {
+	return func(c *Client) { c.authToken = token }
+}
+
+// WithMaxRetries overrides the default retry count (2).
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *Client) { c.MaxRetries = maxRetries }
+}
+
+// New creates a Client for the gateway at baseURL, e.g. "https://qbridge.internal:8443".
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    baseURL,
+		httpClient: http.DefaultClient,
+		MaxRetries: 2,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// SubmitRequest is the body accepted by POST /v1/submit.
+type SubmitRequest struct {
+	AgentID string          `json:"agent_id"`
+	Payload json.RawMessage `json:"payload"`
+	// TTLMillis, when set, is how long the request stays valid after
+	// submission; a picked-up-but-late message is dropped rather than run.
+	TTLMillis *uint64 `json:"ttl_ms,omitempty"`
+}
+
+// SubmitResponse is returned by both Submit and SubmitBatch.
+type SubmitResponse struct {
+	RequestID uuid.UUID `json:"request_id"`
+}
+
+// Result is a completed request's output, as returned by GetResult.
+type Result struct {
+	AgentID      string          `json:"agent_id"`
+	AgentVersion uint32          `json:"agent_version"`
+	Value        json.RawMessage `json:"value"`
+}
+
+// Submit enqueues a single request and returns its assigned ID.
+func (c *Client) Submit(ctx context.Context, req SubmitRequest) (SubmitResponse, error) {
+	var resp SubmitResponse
+	err := c.doJSON(ctx, http.MethodPost, "/v1/submit", req, &resp)
+	return resp, err
+}
+
+// SubmitBatch submits several requests, stopping at the first failure. The
+// gateway has no batch endpoint of its own, so this issues one /v1/submit
+// call per request; callers that need all-or-nothing semantics should submit
+// with an Idempotency-Key and retry the whole batch on partial failure.
+func (c *Client) SubmitBatch(ctx context.Context, reqs []SubmitRequest) ([]SubmitResponse, error) {
+	responses := make([]SubmitResponse, 0, len(reqs))
+	for i, req := range reqs {
+		resp, err := c.Submit(ctx, req)
+		if err != nil {
+			return responses, fmt.Errorf("submitting request %d of %d: %w", i, len(reqs), err)
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+// GetResult fetches the result for requestID. It returns ErrResultPending if
+// the request hasn't completed yet (or never existed — the gateway can't
+// tell the two apart from a bare GET).
+func (c *Client) GetResult(ctx context.Context, requestID uuid.UUID) (Result, error) {
+	var result Result
+	path := fmt.Sprintf("/v1/results/%s", requestID)
+	status, err := c.do(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return Result{}, err
+	}
+	if status == http.StatusNoContent {
+		return Result{}, ErrResultPending
+	}
+	return result, nil
+}
+
+// GetStatus reports whether requestID's result is ready yet, without
+// decoding the result value.
+func (c *Client) GetStatus(ctx context.Context, requestID uuid.UUID) (Status, error) {
+	_, err := c.GetResult(ctx, requestID)
+	switch {
+	case err == nil:
+		return StatusComplete, nil
+	case IsErrResultPending(err):
+		return StatusPending, nil
+	default:
+		return "", err
+	}
+}
+
+// Status is the coarse lifecycle state reported by GetStatus.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusComplete Status = "complete"
+)
+
+// WaitForResult polls GetResult at pollInterval until it succeeds or ctx is
+// done, returning ctx.Err() on timeout or cancellation.
+func (c *Client) WaitForResult(ctx context.Context, requestID uuid.UUID, pollInterval time.Duration) (Result, error) {
+	for {
+		result, err := c.GetResult(ctx, requestID)
+		if err == nil {
+			return result, nil
+		}
+		if !IsErrResultPending(err) {
+			return Result{}, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return Result{}, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// doJSON is do with a JSON-encoded request body and a 2xx-only success path.
+func (c *Client) doJSON(ctx context.Context, method, path string, body, out interface{}) error {
+	_, err := c.do(ctx, method, path, body, out)
+	return err
+}
+
+// do sends one request, retrying transient failures up to MaxRetries times,
+// and decodes a JSON response body into out (if non-nil and the body is
+// non-empty). It returns the final HTTP status code even on success, so
+// callers can distinguish e.g. 200 from 204.
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) (int, error) {
+	var bodyBytes []byte
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("encoding request body: %w", err)
+		}
+		bodyBytes = encoded
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-time.After(200 * time.Millisecond):
+			}
+		}
+
+		status, respBody, err := c.doOnce(ctx, method, path, bodyBytes)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if status >= 500 || status == http.StatusTooManyRequests {
+			lastErr = c.errorFromBody(status, respBody)
+			continue
+		}
+		if status >= 400 {
+			return status, c.errorFromBody(status, respBody)
+		}
+
+		if out != nil && len(respBody) > 0 {
+			if err := json.Unmarshal(respBody, out); err != nil {
+				return status, fmt.Errorf("decoding response body: %w", err)
+			}
+		}
+		return status, nil
+	}
+	return 0, lastErr
+}
+
+func (c *Client) doOnce(ctx context.Context, method, path string, body []byte) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, fmt.Errorf("building request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("reading response body: %w", err)
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+// errorFromBody decodes the gateway's `{"error": ..., "code": ...}` shape
+// into an *APIError, falling back to the raw body if it doesn't parse.
+func (c *Client) errorFromBody(status int, body []byte) error {
+	var decoded struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+	if err := json.Unmarshal(body, &decoded); err != nil || decoded.Code == "" {
+		return &APIError{StatusCode: status, Message: string(body)}
+	}
+	return &APIError{StatusCode: status, Code: decoded.Code, Message: decoded.Error}
+}
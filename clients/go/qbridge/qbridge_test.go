@@ -0,0 +1,62 @@
+package qbridge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSubmitReturnsRequestID(t *testing.T) {
+	wantID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/submit" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SubmitResponse{RequestID: wantID})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	resp, err := client.Submit(context.Background(), SubmitRequest{AgentID: "agent-1", Payload: json.RawMessage(`{"a":1}`)})
+	if err != nil {
+		t.Fatalf("Submit returned error: %v", err)
+	}
+	if resp.RequestID != wantID {
+		t.Fatalf("got request id %s, want %s", resp.RequestID, wantID)
+	}
+}
+
+func TestGetResultReturnsPendingOnNoContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	_, err := client.GetResult(context.Background(), uuid.New())
+	if !IsErrResultPending(err) {
+		t.Fatalf("got err %v, want ErrResultPending", err)
+	}
+}
+
+func TestWaitForResultTimesOut(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.WaitForResult(ctx, uuid.New(), 10*time.Millisecond)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("got err %v, want context.DeadlineExceeded", err)
+	}
+}